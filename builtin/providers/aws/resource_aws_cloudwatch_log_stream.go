@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+func resourceAwsCloudWatchLogStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchLogStreamCreate,
+		Read:   resourceAwsCloudWatchLogStreamRead,
+		Delete: resourceAwsCloudWatchLogStreamDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					validateStringLengthAndPattern(v.(string), k, 512, `[^:*]*`, errors)
+					return
+				},
+			},
+			"log_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					validateStringLengthAndPattern(v.(string), k, 512, `[\.\-_/#A-Za-z0-9]+`, errors)
+					return
+				},
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"stored_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	name := d.Get("name").(string)
+	logGroupName := d.Get("log_group_name").(string)
+
+	log.Printf("[DEBUG] Creating CloudWatch Log Stream: %s", name)
+	_, err := conn.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Creating CloudWatch Log Stream failed: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", logGroupName, name))
+	log.Println("[INFO] CloudWatch Log Stream created")
+
+	return resourceAwsCloudWatchLogStreamRead(d, meta)
+}
+
+func resourceAwsCloudWatchLogStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	logGroupName, name := parseCloudWatchLogStreamID(d.Id())
+
+	log.Printf("[DEBUG] Reading CloudWatch Log Stream: %s", name)
+	ls, err := lookupCloudWatchLogStream(conn, name, logGroupName, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Found CloudWatch Log Stream: %#v", *ls)
+
+	d.Set("name", *ls.LogStreamName)
+	d.Set("log_group_name", logGroupName)
+	d.Set("arn", *ls.Arn)
+	d.Set("creation_time", *ls.CreationTime)
+
+	// storedBytes is on its way to deprecation in the API, so it may come back
+	// as nil.
+	if ls.StoredBytes != nil {
+		d.Set("stored_bytes", *ls.StoredBytes)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchLogStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	logGroupName, name := parseCloudWatchLogStreamID(d.Id())
+
+	log.Printf("[INFO] Deleting CloudWatch Log Stream: %s", d.Id())
+	_, err := conn.DeleteLogStream(&cloudwatchlogs.DeleteLogStreamInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting CloudWatch Log Stream: %s", err)
+	}
+
+	log.Println("[INFO] CloudWatch Log Stream deleted")
+
+	d.SetId("")
+
+	return nil
+}
+
+func lookupCloudWatchLogStream(conn *cloudwatchlogs.CloudWatchLogs,
+	name string, logGroupName string, nextToken *string) (*cloudwatchlogs.LogStream, error) {
+	input := &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName:        aws.String(logGroupName),
+		LogStreamNamePrefix: aws.String(name),
+		NextToken:           nextToken,
+	}
+
+	resp, err := conn.DescribeLogStreams(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ls := range resp.LogStreams {
+		if *ls.LogStreamName == name {
+			return ls, nil
+		}
+	}
+
+	if resp.NextToken != nil {
+		return lookupCloudWatchLogStream(conn, name, logGroupName, resp.NextToken)
+	}
+
+	return nil, fmt.Errorf("CloudWatch Log Stream %q for Log Group %q not found", name, logGroupName)
+}
+
+func parseCloudWatchLogStreamID(id string) (logGroupName, name string) {
+	parts := strings.SplitN(id, ":", 2)
+	logGroupName = parts[0]
+	name = parts[1]
+	return
+}
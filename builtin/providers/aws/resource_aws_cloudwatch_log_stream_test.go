@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchLogStream_basic(t *testing.T) {
+	var ls cloudwatchlogs.LogStream
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogStreamDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloudWatchLogStreamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogStreamExists(
+						"aws_cloudwatch_log_stream.foobar", &ls),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_stream.foobar", "name", "foo-bar-stream"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_stream.foobar", "log_group_name", "foo-bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchLogStreamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_stream" {
+			continue
+		}
+
+		logGroupName, name := parseCloudWatchLogStreamID(rs.Primary.ID)
+
+		_, err := lookupCloudWatchLogStream(conn, name, logGroupName, nil)
+		if err == nil {
+			return fmt.Errorf("LogStream Still Exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchLogStreamExists(n string, ls *cloudwatchlogs.LogStream) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		logGroupName, name := parseCloudWatchLogStreamID(rs.Primary.ID)
+		found, err := lookupCloudWatchLogStream(conn, name, logGroupName, nil)
+		if err != nil {
+			return err
+		}
+
+		*ls = *found
+
+		return nil
+	}
+}
+
+var testAccAWSCloudWatchLogStreamConfig = `
+resource "aws_cloudwatch_log_group" "foobar" {
+    name = "foo-bar"
+}
+
+resource "aws_cloudwatch_log_stream" "foobar" {
+    name = "foo-bar-stream"
+    log_group_name = "${aws_cloudwatch_log_group.foobar.name}"
+}
+`
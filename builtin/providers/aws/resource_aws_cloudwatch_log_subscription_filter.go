@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsCloudwatchLogSubscriptionFilterCreate,
+		Read:          resourceAwsCloudwatchLogSubscriptionFilterRead,
+		Update:        resourceAwsCloudwatchLogSubscriptionFilterUpdate,
+		Delete:        resourceAwsCloudwatchLogSubscriptionFilterDelete,
+		CustomizeDiff: resourceAwsCloudwatchLogSubscriptionFilterCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					validateStringLengthAndPattern(v.(string), k, 512, `[^:*]*`, errors)
+					return
+				},
+			},
+			"log_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					validateStringLengthAndPattern(v.(string), k, 512, `[\.\-_/#A-Za-z0-9]+`, errors)
+					return
+				},
+			},
+			"filter_pattern": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					validateStringLengthAndPattern(v.(string), k, 512, ``, errors)
+					return
+				},
+			},
+			"destination_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"distribution": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "Random" && value != "ByLogStream" {
+						errors = append(errors, fmt.Errorf(
+							"%q must be one of %q or %q", k, "Random", "ByLogStream"))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsCloudwatchLogSubscriptionFilterCustomizeDiff enforces that
+// role_arn is set when destination_arn points at a Kinesis stream or
+// Firehose delivery stream; Lambda destinations authorize the invocation
+// via a resource-based policy instead and don't need one.
+func resourceAwsCloudwatchLogSubscriptionFilterCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get("role_arn").(string) != "" {
+		return nil
+	}
+
+	destinationArn := diff.Get("destination_arn").(string)
+	parts := strings.SplitN(destinationArn, ":", 6)
+	if len(parts) < 3 {
+		return nil
+	}
+
+	switch parts[2] {
+	case "kinesis", "firehose":
+		return fmt.Errorf("role_arn is required when destination_arn is a %s stream", parts[2])
+	}
+
+	return nil
+}
+
+func resourceAwsCloudwatchLogSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	name := d.Get("name").(string)
+	logGroupName := d.Get("log_group_name").(string)
+
+	params := cloudwatchlogs.PutSubscriptionFilterInput{
+		FilterName:     aws.String(name),
+		LogGroupName:   aws.String(logGroupName),
+		FilterPattern:  aws.String(d.Get("filter_pattern").(string)),
+		DestinationArn: aws.String(d.Get("destination_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distribution"); ok {
+		params.Distribution = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating SubscriptionFilter %#v", params)
+	_, err := conn.PutSubscriptionFilter(&params)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Filter: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, logGroupName))
+	log.Println("[INFO] CloudWatch Log Subscription Filter created")
+
+	return resourceAwsCloudwatchLogSubscriptionFilterRead(d, meta)
+}
+
+func resourceAwsCloudwatchLogSubscriptionFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	name := d.Get("name").(string)
+	logGroupName := d.Get("log_group_name").(string)
+
+	params := cloudwatchlogs.PutSubscriptionFilterInput{
+		FilterName:     aws.String(name),
+		LogGroupName:   aws.String(logGroupName),
+		FilterPattern:  aws.String(d.Get("filter_pattern").(string)),
+		DestinationArn: aws.String(d.Get("destination_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distribution"); ok {
+		params.Distribution = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating SubscriptionFilter %#v", params)
+	_, err := conn.PutSubscriptionFilter(&params)
+	if err != nil {
+		return fmt.Errorf("Error updating Subscription Filter: %s", err)
+	}
+
+	log.Println("[INFO] CloudWatch Log Subscription Filter updated")
+
+	return resourceAwsCloudwatchLogSubscriptionFilterRead(d, meta)
+}
+
+func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	name, logGroupName := parseCloudWatchLogSubscriptionFilterID(d.Id())
+
+	log.Printf("[DEBUG] Reading CloudWatch Log Subscription Filter: %s", name)
+	sf, err := lookupCloudWatchLogSubscriptionFilter(conn, name, logGroupName, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Found CloudWatch Log Subscription Filter: %#v", *sf)
+
+	d.Set("name", *sf.FilterName)
+	d.Set("log_group_name", logGroupName)
+	d.Set("filter_pattern", *sf.FilterPattern)
+	d.Set("destination_arn", *sf.DestinationArn)
+	if sf.RoleArn != nil {
+		d.Set("role_arn", *sf.RoleArn)
+	}
+	if sf.Distribution != nil {
+		d.Set("distribution", *sf.Distribution)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudwatchLogSubscriptionFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	name, logGroupName := parseCloudWatchLogSubscriptionFilterID(d.Id())
+
+	params := cloudwatchlogs.DeleteSubscriptionFilterInput{
+		FilterName:   aws.String(name),
+		LogGroupName: aws.String(logGroupName),
+	}
+
+	log.Printf("[INFO] Deleting CloudWatch Log Subscription Filter: %s", d.Id())
+	_, err := conn.DeleteSubscriptionFilter(&params)
+	if err != nil {
+		return fmt.Errorf("Error deleting Subscription Filter: %s", err)
+	}
+
+	log.Println("[INFO] CloudWatch Log Subscription Filter deleted")
+
+	d.SetId("")
+
+	return nil
+}
+
+func lookupCloudWatchLogSubscriptionFilter(conn *cloudwatchlogs.CloudWatchLogs,
+	name string, logGroupName string, nextToken *string) (*cloudwatchlogs.SubscriptionFilter, error) {
+	input := &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+		FilterNamePrefix: aws.String(name),
+		LogGroupName:     aws.String(logGroupName),
+		NextToken:        nextToken,
+	}
+
+	resp, err := conn.DescribeSubscriptionFilters(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sf := range resp.SubscriptionFilters {
+		if *sf.FilterName == name {
+			return sf, nil
+		}
+	}
+
+	if resp.NextToken != nil {
+		return lookupCloudWatchLogSubscriptionFilter(conn, name, logGroupName, resp.NextToken)
+	}
+
+	return nil, fmt.Errorf("CloudWatch Log Subscription Filter %q for Log Group %q not found", name, logGroupName)
+}
+
+func parseCloudWatchLogSubscriptionFilterID(id string) (name, logGroupName string) {
+	parts := strings.SplitN(id, ":", 2)
+	name = parts[0]
+	logGroupName = parts[1]
+	return
+}
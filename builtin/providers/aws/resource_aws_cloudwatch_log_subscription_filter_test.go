@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudwatchLogSubscriptionFilter_basic(t *testing.T) {
+	var sf cloudwatchlogs.SubscriptionFilter
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudwatchLogSubscriptionFilterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloudwatchLogSubscriptionFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudwatchLogSubscriptionFilterExists(
+						"aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", &sf),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "name", "test_lambdafunction_logfilter"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "filter_pattern", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsCloudwatchLogSubscriptionFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_subscription_filter" {
+			continue
+		}
+
+		name, logGroupName := parseCloudWatchLogSubscriptionFilterID(rs.Primary.ID)
+
+		_, err := lookupCloudWatchLogSubscriptionFilter(conn, name, logGroupName, nil)
+		if err == nil {
+			return fmt.Errorf("Subscription Filter Still Exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, sf *cloudwatchlogs.SubscriptionFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		name, logGroupName := parseCloudWatchLogSubscriptionFilterID(rs.Primary.ID)
+		found, err := lookupCloudWatchLogSubscriptionFilter(conn, name, logGroupName, nil)
+		if err != nil {
+			return err
+		}
+
+		*sf = *found
+
+		return nil
+	}
+}
+
+var testAccAWSCloudwatchLogSubscriptionFilterConfig = `
+resource "aws_cloudwatch_log_group" "logs" {
+    name = "example_lambda_name"
+}
+
+resource "aws_iam_role" "iam_for_lambda" {
+    name = "iam_for_lambda_cwl_subscription"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test_lambdafunction" {
+    filename = "test-fixtures/lambdatest.zip"
+    function_name = "example_lambda_name"
+    role = "${aws_iam_role.iam_for_lambda.arn}"
+    handler = "exports.handler"
+    runtime = "nodejs"
+}
+
+resource "aws_cloudwatch_log_subscription_filter" "test_lambdafunction_logfilter" {
+    name = "test_lambdafunction_logfilter"
+    log_group_name = "${aws_cloudwatch_log_group.logs.name}"
+    filter_pattern = ""
+    destination_arn = "${aws_lambda_function.test_lambdafunction.arn}"
+}
+`
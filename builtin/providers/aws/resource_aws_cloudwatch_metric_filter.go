@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,6 +17,7 @@ func resourceAwsCloudWatchMetricFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudWatchMetricFilterCreate,
 		Read:   resourceAwsCloudWatchMetricFilterRead,
+		Update: resourceAwsCloudWatchMetricFilterUpdate,
 		Delete: resourceAwsCloudWatchMetricFilterDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -31,7 +33,6 @@ func resourceAwsCloudWatchMetricFilter() *schema.Resource {
 			"filter_pattern": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					validateStringLengthAndPattern(v.(string), k, 512, ``, errors)
 					return
@@ -49,7 +50,6 @@ func resourceAwsCloudWatchMetricFilter() *schema.Resource {
 			"metric_transformations": &schema.Schema{
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"metric_name": &schema.Schema{
@@ -76,6 +76,28 @@ func resourceAwsCloudWatchMetricFilter() *schema.Resource {
 								return
 							},
 						},
+						"default_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								if v.(string) == "" {
+									return
+								}
+								if _, err := strconv.ParseFloat(v.(string), 64); err != nil {
+									errors = append(errors, fmt.Errorf(
+										"%q must be a float", k))
+								}
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								oldFloat, oldErr := strconv.ParseFloat(old, 64)
+								newFloat, newErr := strconv.ParseFloat(new, 64)
+								if oldErr != nil || newErr != nil {
+									return old == new
+								}
+								return oldFloat == newFloat
+							},
+						},
 					},
 				},
 			},
@@ -108,6 +130,30 @@ func resourceAwsCloudWatchMetricFilterCreate(d *schema.ResourceData, meta interf
 	return resourceAwsCloudWatchMetricFilterRead(d, meta)
 }
 
+func resourceAwsCloudWatchMetricFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	params := cloudwatchlogs.PutMetricFilterInput{
+		FilterName:    aws.String(d.Get("filter_name").(string)),
+		FilterPattern: aws.String(d.Get("filter_pattern").(string)),
+		LogGroupName:  aws.String(d.Get("log_group_name").(string)),
+	}
+
+	if attr, ok := d.GetOk("metric_transformations"); ok {
+		metricFilters := buildMetricTransformations(attr.(*schema.Set).List())
+		params.MetricTransformations = metricFilters
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Metric Filter: %#v", params)
+	_, err := conn.PutMetricFilter(&params)
+	if err != nil {
+		return fmt.Errorf("Updating CloudWatch Metric Filter failed: %s", err)
+	}
+
+	log.Println("[INFO] CloudWatch Metric Filter updated")
+
+	return resourceAwsCloudWatchMetricFilterRead(d, meta)
+}
+
 func resourceAwsCloudWatchMetricFilterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	fn, lgn := parseCloudWatchMetricFilterID(d.Id())
@@ -204,20 +250,31 @@ func buildMetricTransformations(configured []interface{}) []*cloudwatchlogs.Metr
 		filter.MetricNamespace = aws.String(m["metric_namespace"].(string))
 		filter.MetricValue = aws.String(m["metric_value"].(string))
 
+		if v, ok := m["default_value"].(string); ok && v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err == nil {
+				filter.DefaultValue = aws.Float64(f)
+			}
+		}
+
 		filters = append(filters, &filter)
 	}
 
 	return filters
 }
 
-func getMetricTransformationsAsMapSlice(list []*cloudwatchlogs.MetricTransformation) []map[string]string {
-	result := make([]map[string]string, 0, len(list))
+func getMetricTransformationsAsMapSlice(list []*cloudwatchlogs.MetricTransformation) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
 	for _, mt := range list {
-		item := make(map[string]string)
+		item := make(map[string]interface{})
 		item["metric_name"] = *mt.MetricName
 		item["metric_namespace"] = *mt.MetricNamespace
 		item["metric_value"] = *mt.MetricValue
 
+		if mt.DefaultValue != nil {
+			item["default_value"] = strconv.FormatFloat(*mt.DefaultValue, 'f', -1, 64)
+		}
+
 		result = append(result, item)
 	}
 
@@ -12,6 +12,7 @@ import (
 
 func TestAccAWSCloudWatchMetricFilter_basic(t *testing.T) {
 	var mf cloudwatchlogs.MetricFilter
+	var filterID string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -24,16 +25,80 @@ func TestAccAWSCloudWatchMetricFilter_basic(t *testing.T) {
 					testMetricFilterRequiredResourcesExist(
 						"aws_cloudwatch_log_group.bazqux",
 						"aws_cloudwatch_metric_filter.foobar", &mf),
+					testAccCheckCloudWatchMetricFilterID("aws_cloudwatch_metric_filter.foobar", &filterID),
 					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "filter_name", "foo-bar-filter"),
 					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "filter_pattern", "{ ($.foo = \"bar\") }"),
 					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "log_group_name", "foo-bar"),
 					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "log_group_name", "foo-bar"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccAWSCloudWatchMetricFilterConfigModified,
+				Check: resource.ComposeTestCheckFunc(
+					testMetricFilterRequiredResourcesExist(
+						"aws_cloudwatch_log_group.bazqux",
+						"aws_cloudwatch_metric_filter.foobar", &mf),
+					testAccCheckCloudWatchMetricFilterID("aws_cloudwatch_metric_filter.foobar", &filterID),
+					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "filter_pattern", "{ ($.foo = \"baz\") }"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_metric_filter.foobar", "metric_transformations.#", "1"),
+					testAccCheckCloudWatchMetricFilterDefaultValue(&mf, 5),
+				),
+			},
+			resource.TestStep{
+				// default_value = "5.0" is float-equal to the "5" already in
+				// state; the DiffSuppressFunc should treat this as a no-op
+				// rather than showing a perpetual diff.
+				Config:             testAccAWSCloudWatchMetricFilterConfigUnnormalizedDefaultValue,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 		},
 	})
 }
 
+// testAccCheckCloudWatchMetricFilterID asserts that the resource's ID is
+// unchanged from the last time this check ran. The ID is built from
+// filter_name and log_group_name only, so a destroy/create that keeps both
+// names yields the same ID and is not detected by this check.
+func testAccCheckCloudWatchMetricFilterID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set: %s", n)
+		}
+
+		if *id != "" && *id != rs.Primary.ID {
+			return fmt.Errorf("Expected CloudWatch Metric Filter ID to remain %q, got %q: resource was replaced", *id, rs.Primary.ID)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+// testAccCheckCloudWatchMetricFilterDefaultValue asserts that the filter
+// returned by the API has a single metric transformation whose default
+// value is expected.
+func testAccCheckCloudWatchMetricFilterDefaultValue(mf *cloudwatchlogs.MetricFilter, expected float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(mf.MetricTransformations) != 1 {
+			return fmt.Errorf("Expected 1 metric transformation, got %d", len(mf.MetricTransformations))
+		}
+
+		dv := mf.MetricTransformations[0].DefaultValue
+		if dv == nil || *dv != expected {
+			return fmt.Errorf("Expected default_value %v, got %v", expected, dv)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSCloudWatchMetricFilterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
 
@@ -107,3 +172,39 @@ resource "aws_cloudwatch_metric_filter" "foobar" {
 	}
 }
 `
+
+var testAccAWSCloudWatchMetricFilterConfigModified = `
+resource "aws_cloudwatch_log_group" "bazqux" {
+    name = "foo-bar"
+}
+
+resource "aws_cloudwatch_metric_filter" "foobar" {
+	filter_name = "foo-bar-filter"
+	filter_pattern = "{ ($.foo = \"baz\") }"
+	log_group_name = "${aws_cloudwatch_log_group.bazqux.name}"
+	metric_transformations = {
+		metric_name = "foo-bar-metric"
+		metric_namespace = "foo/bar"
+		metric_value = "1"
+		default_value = "5"
+	}
+}
+`
+
+var testAccAWSCloudWatchMetricFilterConfigUnnormalizedDefaultValue = `
+resource "aws_cloudwatch_log_group" "bazqux" {
+    name = "foo-bar"
+}
+
+resource "aws_cloudwatch_metric_filter" "foobar" {
+	filter_name = "foo-bar-filter"
+	filter_pattern = "{ ($.foo = \"baz\") }"
+	log_group_name = "${aws_cloudwatch_log_group.bazqux.name}"
+	metric_transformations = {
+		metric_name = "foo-bar-metric"
+		metric_namespace = "foo/bar"
+		metric_value = "1"
+		default_value = "5.0"
+	}
+}
+`